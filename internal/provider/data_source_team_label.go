@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-community-providers/terraform-plugin-framework-utils/validators"
+)
+
+var _ datasource.DataSource = &TeamLabelDataSource{}
+
+func NewTeamLabelDataSource() datasource.DataSource {
+	return &TeamLabelDataSource{}
+}
+
+type TeamLabelDataSource struct {
+	client *graphql.Client
+}
+
+type TeamLabelDataSourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	TeamKey     types.String `tfsdk:"team_key"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Color       types.String `tfsdk:"color"`
+	TeamId      types.String `tfsdk:"team_id"`
+}
+
+func (d *TeamLabelDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_label"
+}
+
+func (d *TeamLabelDataSource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Linear team label.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "Identifier of the label. When set, the label is looked up by `id` and `team_key` and `name` are not used for the lookup.",
+				Type:                types.StringType,
+				Optional:            true,
+				Computed:            true,
+			},
+			"team_key": {
+				MarkdownDescription: "Key of the team. Required with `name`.",
+				Type:                types.StringType,
+				Optional:            true,
+				Validators: []tfsdk.AttributeValidator{
+					validators.MinLength(1),
+				},
+			},
+			"name": {
+				MarkdownDescription: "Name of the label. Required with `team_key`.",
+				Type:                types.StringType,
+				Optional:            true,
+				Computed:            true,
+			},
+			"description": {
+				MarkdownDescription: "Description of the label.",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+			"color": {
+				MarkdownDescription: "Color of the label.",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+			"team_id": {
+				MarkdownDescription: "Identifier of the team.",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (d *TeamLabelDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TeamLabelDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *TeamLabelDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var issueLabel IssueLabel
+
+	if !data.Id.IsNull() {
+		response, err := getLabel(context.Background(), *d.client, data.Id.Value)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team label, got error: %s", err))
+			return
+		}
+
+		issueLabel = response.IssueLabel
+	} else {
+		if data.TeamKey.IsNull() || data.Name.IsNull() {
+			resp.Diagnostics.AddError("Invalid Configuration", "Either `id` or both `team_key` and `name` must be set.")
+			return
+		}
+
+		response, err := findTeamLabel(context.Background(), *d.client, data.Name.Value, data.TeamKey.Value)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team label, got error: %s", err))
+			return
+		}
+
+		if len(response.IssueLabels.Nodes) != 1 {
+			resp.Diagnostics.AddError("Client Error", "Unable to read team label, got error: label not found")
+			return
+		}
+
+		issueLabel = response.IssueLabels.Nodes[0]
+	}
+
+	data.Id = types.String{Value: issueLabel.Id}
+	data.Name = types.String{Value: issueLabel.Name}
+
+	if issueLabel.Description != nil {
+		data.Description = types.String{Value: *issueLabel.Description}
+	}
+
+	if issueLabel.Color != nil {
+		data.Color = types.String{Value: *issueLabel.Color}
+	}
+
+	if issueLabel.Team != nil {
+		data.TeamId = types.String{Value: issueLabel.Team.Id}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTeamLabelDataSource(t *testing.T) {
+	teamKey := os.Getenv("LINEAR_TEAM_KEY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamLabelDataSourceConfig(teamKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.linear_team_label.test", "name", "Bug"),
+					resource.TestCheckResourceAttrSet("data.linear_team_label.test", "id"),
+					resource.TestCheckResourceAttrSet("data.linear_team_label.test", "team_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTeamLabelDataSourceConfig(teamKey string) string {
+	return fmt.Sprintf(`
+data "linear_team_label" "test" {
+  team_key = %[1]q
+  name     = "Bug"
+}
+`, teamKey)
+}
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/terraform-community-providers/terraform-plugin-framework-utils/validators"
+)
+
+var _ datasource.DataSource = &TeamLabelsDataSource{}
+
+func NewTeamLabelsDataSource() datasource.DataSource {
+	return &TeamLabelsDataSource{}
+}
+
+type TeamLabelsDataSource struct {
+	client *graphql.Client
+}
+
+type TeamLabelsDataSourceLabelModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Color       types.String `tfsdk:"color"`
+}
+
+type TeamLabelsDataSourceModel struct {
+	Id     types.String                     `tfsdk:"id"`
+	TeamId types.String                     `tfsdk:"team_id"`
+	Labels []TeamLabelsDataSourceLabelModel `tfsdk:"labels"`
+}
+
+func (d *TeamLabelsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_labels"
+}
+
+func (d *TeamLabelsDataSource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Labels belonging to a Linear team.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				MarkdownDescription: "Identifier of the team.",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+			"team_id": {
+				MarkdownDescription: "Identifier of the team.",
+				Type:                types.StringType,
+				Required:            true,
+				Validators: []tfsdk.AttributeValidator{
+					validators.Match(uuidRegex()),
+				},
+			},
+			"labels": {
+				MarkdownDescription: "Labels belonging to the team.",
+				Computed:            true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"id": {
+						MarkdownDescription: "Identifier of the label.",
+						Type:                types.StringType,
+						Computed:            true,
+					},
+					"name": {
+						MarkdownDescription: "Name of the label.",
+						Type:                types.StringType,
+						Computed:            true,
+					},
+					"description": {
+						MarkdownDescription: "Description of the label.",
+						Type:                types.StringType,
+						Computed:            true,
+					},
+					"color": {
+						MarkdownDescription: "Color of the label.",
+						Type:                types.StringType,
+						Computed:            true,
+					},
+				}),
+			},
+		},
+	}, nil
+}
+
+func (d *TeamLabelsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TeamLabelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *TeamLabelsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := teamLabels(context.Background(), *d.client, data.TeamId.Value)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team labels, got error: %s", err))
+		return
+	}
+
+	if response.Team == nil {
+		resp.Diagnostics.AddError("Client Error", "Unable to read team labels, got error: team not found")
+		return
+	}
+
+	labels := make([]TeamLabelsDataSourceLabelModel, 0, len(response.Team.Labels.Nodes))
+
+	for _, issueLabel := range response.Team.Labels.Nodes {
+		label := TeamLabelsDataSourceLabelModel{
+			Id:   types.String{Value: issueLabel.Id},
+			Name: types.String{Value: issueLabel.Name},
+		}
+
+		if issueLabel.Description != nil {
+			label.Description = types.String{Value: *issueLabel.Description}
+		}
+
+		if issueLabel.Color != nil {
+			label.Color = types.String{Value: *issueLabel.Color}
+		}
+
+		labels = append(labels, label)
+	}
+
+	data.Id = types.String{Value: data.TeamId.Value}
+	data.Labels = labels
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
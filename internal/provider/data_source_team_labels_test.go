@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTeamLabelsDataSource(t *testing.T) {
+	teamKey := os.Getenv("LINEAR_TEAM_KEY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamLabelsDataSourceConfig(teamKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.linear_team_labels.test", "id"),
+					resource.TestCheckResourceAttrSet("data.linear_team_labels.test", "labels.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTeamLabelsDataSourceConfig(teamKey string) string {
+	return fmt.Sprintf(`
+data "linear_team" "test" {
+  key = %[1]q
+}
+
+data "linear_team_labels" "test" {
+  team_id = data.linear_team.test.id
+}
+`, teamKey)
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"os"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/oauth2"
+)
+
+var _ provider.Provider = &LinearProvider{}
+
+type LinearProvider struct {
+	version string
+}
+
+type LinearProviderModel struct {
+	ApiKey types.String `tfsdk:"api_key"`
+}
+
+func (p *LinearProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "linear"
+	resp.Version = p.version
+}
+
+func (p *LinearProvider) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Interact with Linear.",
+		Attributes: map[string]tfsdk.Attribute{
+			"api_key": {
+				MarkdownDescription: "API key used to authenticate with Linear. Can also be set with the `LINEAR_API_KEY` environment variable.",
+				Type:                types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+			},
+		},
+	}, nil
+}
+
+func (p *LinearProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var data LinearProviderModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiKey := os.Getenv("LINEAR_API_KEY")
+
+	if !data.ApiKey.IsNull() {
+		apiKey = data.ApiKey.Value
+	}
+
+	if apiKey == "" {
+		resp.Diagnostics.AddError(
+			"Missing API Key Configuration",
+			"While configuring the provider, the API key was not found in the LINEAR_API_KEY environment variable or provider configuration block api_key attribute.",
+		)
+
+		return
+	}
+
+	httpClient := oauth2.NewClient(ctx, oauth2.StaticTokenSource(&oauth2.Token{AccessToken: apiKey}))
+	client := graphql.NewClient("https://api.linear.app/graphql", httpClient)
+
+	resp.ResourceData = &client
+	resp.DataSourceData = &client
+}
+
+func (p *LinearProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		NewTeamLabelResource,
+		NewTeamLabelGroupResource,
+		NewWorkspaceLabelResource,
+	}
+}
+
+func (p *LinearProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{
+		NewTeamLabelDataSource,
+		NewTeamLabelsDataSource,
+	}
+}
+
+func New(version string) func() provider.Provider {
+	return func() provider.Provider {
+		return &LinearProvider{
+			version: version,
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// testAccProtoV6ProviderFactories are used to instantiate a provider during
+// acceptance testing. The factory function is called for each Terraform CLI
+// command executed to create a provider server to which the CLI can
+// reattach.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"linear": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+func testAccPreCheck(t *testing.T) {
+	if v := os.Getenv("LINEAR_API_KEY"); v == "" {
+		t.Fatal("LINEAR_API_KEY must be set for acceptance tests")
+	}
+
+	if v := os.Getenv("LINEAR_TEAM_KEY"); v == "" {
+		t.Fatal("LINEAR_TEAM_KEY must be set for acceptance tests")
+	}
+}
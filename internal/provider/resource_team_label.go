@@ -28,11 +28,14 @@ type TeamLabelResource struct {
 }
 
 type TeamLabelResourceModel struct {
-	Id          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Color       types.String `tfsdk:"color"`
-	TeamId      types.String `tfsdk:"team_id"`
+	Id                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	Color             types.String `tfsdk:"color"`
+	TeamId            types.String `tfsdk:"team_id"`
+	ParentId          types.String `tfsdk:"parent_id"`
+	AdoptExisting     types.Bool   `tfsdk:"adopt_existing"`
+	PreserveOnDestroy types.Bool   `tfsdk:"preserve_on_destroy"`
 }
 
 func (r *TeamLabelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -91,6 +94,29 @@ func (r *TeamLabelResource) GetSchema(ctx context.Context) (tfsdk.Schema, diag.D
 					validators.Match(uuidRegex()),
 				},
 			},
+			"parent_id": {
+				MarkdownDescription: "Identifier of the parent label group.",
+				Type:                types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+					resource.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					validators.Match(uuidRegex()),
+				},
+			},
+			"adopt_existing": {
+				MarkdownDescription: "Adopt a pre-existing label with the same name instead of failing to create it. Useful for Linear's built-in default labels. Defaults to `false`.",
+				Type:                types.BoolType,
+				Optional:            true,
+			},
+			"preserve_on_destroy": {
+				MarkdownDescription: "Do not delete the label when the resource is destroyed, only update it in place on future applies. Defaults to `false`.",
+				Type:                types.BoolType,
+				Optional:            true,
+			},
 		},
 	}, nil
 }
@@ -124,6 +150,22 @@ func (r *TeamLabelResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	if data.AdoptExisting.Value {
+		adopted, err := r.adoptExistingLabel(ctx, data)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to adopt existing team label, got error: %s", err))
+			return
+		}
+
+		if adopted != nil {
+			tflog.Trace(ctx, "adopted an existing team label")
+
+			resp.Diagnostics.Append(resp.State.Set(ctx, adopted)...)
+			return
+		}
+	}
+
 	input := IssueLabelCreateInput{
 		Name:   data.Name.Value,
 		TeamId: &data.TeamId.Value,
@@ -137,6 +179,10 @@ func (r *TeamLabelResource) Create(ctx context.Context, req resource.CreateReque
 		input.Color = &data.Color.Value
 	}
 
+	if !data.ParentId.IsUnknown() {
+		input.ParentId = &data.ParentId.Value
+	}
+
 	response, err := createLabel(context.Background(), *r.client, input)
 
 	if err != nil {
@@ -163,9 +209,80 @@ func (r *TeamLabelResource) Create(ctx context.Context, req resource.CreateReque
 		data.TeamId = types.String{Value: issueLabel.Team.Id}
 	}
 
+	if issueLabel.Parent != nil {
+		data.ParentId = types.String{Value: issueLabel.Parent.Id}
+	} else {
+		data.ParentId = types.String{Null: true}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// adoptExistingLabel looks up a label with the same name on the target team and, if one already
+// exists, reconciles it with the configured description and color instead of failing to create
+// a duplicate. It returns nil if no matching label was found, in which case Create should proceed
+// as usual.
+func (r *TeamLabelResource) adoptExistingLabel(ctx context.Context, data *TeamLabelResourceModel) (*TeamLabelResourceModel, error) {
+	findResponse, err := findTeamLabelByTeamId(context.Background(), *r.client, data.Name.Value, data.TeamId.Value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(findResponse.IssueLabels.Nodes) != 1 {
+		return nil, nil
+	}
+
+	existing := findResponse.IssueLabels.Nodes[0]
+
+	input := IssueLabelUpdateInput{
+		Name: data.Name.Value,
+	}
+
+	if !data.Description.IsNull() {
+		input.Description = &data.Description.Value
+	}
+
+	if !data.Color.IsUnknown() {
+		input.Color = &data.Color.Value
+	}
+
+	if !data.ParentId.IsUnknown() {
+		input.ParentId = &data.ParentId.Value
+	}
+
+	updateResponse, err := updateLabel(context.Background(), *r.client, input, existing.Id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	issueLabel := updateResponse.IssueLabelUpdate.IssueLabel
+
+	data.Id = types.String{Value: issueLabel.Id}
+	data.Name = types.String{Value: issueLabel.Name}
+
+	if issueLabel.Description != nil {
+		data.Description = types.String{Value: *issueLabel.Description}
+	}
+
+	if issueLabel.Color != nil {
+		data.Color = types.String{Value: *issueLabel.Color}
+	}
+
+	if issueLabel.Team != nil {
+		data.TeamId = types.String{Value: issueLabel.Team.Id}
+	}
+
+	if issueLabel.Parent != nil {
+		data.ParentId = types.String{Value: issueLabel.Parent.Id}
+	} else {
+		data.ParentId = types.String{Null: true}
+	}
+
+	return data, nil
+}
+
 func (r *TeamLabelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data *TeamLabelResourceModel
 
@@ -199,6 +316,12 @@ func (r *TeamLabelResource) Read(ctx context.Context, req resource.ReadRequest,
 		data.TeamId = types.String{Value: issueLabel.Team.Id}
 	}
 
+	if issueLabel.Parent != nil {
+		data.ParentId = types.String{Value: issueLabel.Parent.Id}
+	} else {
+		data.ParentId = types.String{Null: true}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -223,6 +346,10 @@ func (r *TeamLabelResource) Update(ctx context.Context, req resource.UpdateReque
 		input.Color = &data.Color.Value
 	}
 
+	if !data.ParentId.IsUnknown() {
+		input.ParentId = &data.ParentId.Value
+	}
+
 	response, err := updateLabel(context.Background(), *r.client, input, data.Id.Value)
 
 	if err != nil {
@@ -249,6 +376,12 @@ func (r *TeamLabelResource) Update(ctx context.Context, req resource.UpdateReque
 		data.TeamId = types.String{Value: issueLabel.Team.Id}
 	}
 
+	if issueLabel.Parent != nil {
+		data.ParentId = types.String{Value: issueLabel.Parent.Id}
+	} else {
+		data.ParentId = types.String{Null: true}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -261,6 +394,11 @@ func (r *TeamLabelResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	if data.PreserveOnDestroy.Value {
+		tflog.Trace(ctx, "preserved a team label on destroy")
+		return
+	}
+
 	_, err := deleteLabel(context.Background(), *r.client, data.Id.Value)
 
 	if err != nil {
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTeamLabelGroupResource(t *testing.T) {
+	teamKey := os.Getenv("LINEAR_TEAM_KEY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamLabelGroupResourceConfig(teamKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("linear_team_label_group.test", "name", "Priority"),
+					resource.TestCheckResourceAttrSet("linear_team_label_group.test", "id"),
+					resource.TestCheckResourceAttrPair("linear_team_label.low", "parent_id", "linear_team_label_group.test", "id"),
+					resource.TestCheckResourceAttrPair("linear_team_label.high", "parent_id", "linear_team_label_group.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "linear_team_label_group.test",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("Priority:%s", teamKey),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "linear_team_label.low",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("Low:%s", teamKey),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTeamLabelGroupResourceConfig(teamKey string) string {
+	return fmt.Sprintf(`
+data "linear_team" "test" {
+  key = %[1]q
+}
+
+resource "linear_team_label_group" "test" {
+  name    = "Priority"
+  team_id = data.linear_team.test.id
+}
+
+resource "linear_team_label" "low" {
+  name      = "Low"
+  team_id   = data.linear_team.test.id
+  parent_id = linear_team_label_group.test.id
+}
+
+resource "linear_team_label" "high" {
+  name      = "High"
+  team_id   = data.linear_team.test.id
+  parent_id = linear_team_label_group.test.id
+}
+`, teamKey)
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTeamLabelResource_adoptExisting(t *testing.T) {
+	teamKey := os.Getenv("LINEAR_TEAM_KEY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamLabelResourceAdoptExistingConfig(teamKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("linear_team_label.bug", "name", "Bug"),
+					resource.TestCheckResourceAttrSet("linear_team_label.bug", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTeamLabelResourceAdoptExistingConfig(teamKey string) string {
+	return fmt.Sprintf(`
+data "linear_team" "test" {
+  key = %[1]q
+}
+
+resource "linear_team_label" "bug" {
+  name           = "Bug"
+  team_id        = data.linear_team.test.id
+  adopt_existing = true
+}
+`, teamKey)
+}
+
+func TestAccTeamLabelResource_preserveOnDestroy(t *testing.T) {
+	teamKey := os.Getenv("LINEAR_TEAM_KEY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamLabelResourcePreserveOnDestroyConfig(teamKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("linear_team_label.preserved", "name", "Preserved"),
+					resource.TestCheckResourceAttr("linear_team_label.preserved", "preserve_on_destroy", "true"),
+				),
+			},
+			{
+				// Removing the resource from config destroys it in Terraform's state, but
+				// preserve_on_destroy means the Delete implementation must not call
+				// deleteLabel, so a subsequent resource with adopt_existing should still find it.
+				Config: testAccTeamLabelResourceAdoptPreservedConfig(teamKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("linear_team_label.readopted", "name", "Preserved"),
+					resource.TestCheckResourceAttrSet("linear_team_label.readopted", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTeamLabelResourcePreserveOnDestroyConfig(teamKey string) string {
+	return fmt.Sprintf(`
+data "linear_team" "test" {
+  key = %[1]q
+}
+
+resource "linear_team_label" "preserved" {
+  name                = "Preserved"
+  team_id             = data.linear_team.test.id
+  preserve_on_destroy = true
+}
+`, teamKey)
+}
+
+func testAccTeamLabelResourceAdoptPreservedConfig(teamKey string) string {
+	return fmt.Sprintf(`
+data "linear_team" "test" {
+  key = %[1]q
+}
+
+resource "linear_team_label" "readopted" {
+  name           = "Preserved"
+  team_id        = data.linear_team.test.id
+  adopt_existing = true
+}
+`, teamKey)
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccWorkspaceLabelResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspaceLabelResourceConfig("Workspace Label"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("linear_workspace_label.test", "name", "Workspace Label"),
+					resource.TestCheckResourceAttrSet("linear_workspace_label.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "linear_workspace_label.test",
+				ImportState:       true,
+				ImportStateId:     "Workspace Label",
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWorkspaceLabelResourceConfig("Workspace Label Renamed"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("linear_workspace_label.test", "name", "Workspace Label Renamed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWorkspaceLabelResourceConfig(name string) string {
+	return `
+resource "linear_workspace_label" "test" {
+  name = "` + name + `"
+}
+`
+}